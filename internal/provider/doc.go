@@ -0,0 +1,19 @@
+// Package provider implements the Terraform provider for the Pterodactyl
+// Panel API.
+//
+// pterodactyl_user (user_resource.go, user_data_source.go,
+// users_data_source.go) depends on the following surface from
+// github.com/Lela810/pterodactyl-client-go. Before bumping or vendoring
+// that module, confirm the pinned release actually provides all of it —
+// GetUserByExternalID and the RootAdmin/Language/ExternalID/Password
+// fields were added on top of the client's original username/email/name
+// surface, and ListUsers's pagination shape is assumed rather than
+// confirmed against a specific release:
+//
+//   - User and PartialUser fields: RootAdmin bool, Language string,
+//     ExternalID string, Password string
+//   - (*Client).GetUserByExternalID(externalID string) (*User, error)
+//   - (*Client).ListUsers(page int) (*UserListResponse, error), where
+//     UserListResponse has Data []User and
+//     Meta.Pagination.{CurrentPage,TotalPages int}
+package provider
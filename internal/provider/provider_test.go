@@ -0,0 +1,31 @@
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// testAccProtoV6ProviderFactories are used to instantiate a provider during
+// acceptance and plan-time tests.
+var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"pterodactyl": providerserver.NewProtocol6WithError(New("test")()),
+}
+
+// providerConfig is a shared provider configuration block. The provider
+// falls back to the PTERODACTYL_URL and PTERODACTYL_API_KEY environment
+// variables when url/api_key are omitted, so acceptance tests can run
+// against a real panel without hardcoding credentials.
+const providerConfig = `
+provider "pterodactyl" {}
+`
+
+// testAccPreCheck verifies the environment is configured for acceptance
+// tests before any are run.
+func testAccPreCheck(t *testing.T) {
+	if os.Getenv("PTERODACTYL_URL") == "" || os.Getenv("PTERODACTYL_API_KEY") == "" {
+		t.Skip("PTERODACTYL_URL and PTERODACTYL_API_KEY must be set for acceptance tests")
+	}
+}
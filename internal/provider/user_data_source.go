@@ -0,0 +1,214 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Lela810/pterodactyl-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &userDataSource{}
+	_ datasource.DataSourceWithConfigure = &userDataSource{}
+)
+
+// NewUserDataSource is a helper function to simplify the provider implementation.
+func NewUserDataSource() datasource.DataSource {
+	return &userDataSource{}
+}
+
+// userDataSource is the data source implementation.
+type userDataSource struct {
+	client *pterodactyl.Client
+}
+
+// userDataSourceModel maps the data source schema data.
+type userDataSourceModel struct {
+	ID         types.Int32  `tfsdk:"id"`
+	Username   types.String `tfsdk:"username"`
+	Email      types.String `tfsdk:"email"`
+	ExternalID types.String `tfsdk:"external_id"`
+	FirstName  types.String `tfsdk:"first_name"`
+	LastName   types.String `tfsdk:"last_name"`
+	RootAdmin  types.Bool   `tfsdk:"root_admin"`
+	Language   types.String `tfsdk:"language"`
+	CreatedAt  types.String `tfsdk:"created_at"`
+	UpdatedAt  types.String `tfsdk:"updated_at"`
+}
+
+// Metadata returns the data source type name.
+func (d *userDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+// Schema defines the schema for the data source.
+func (d *userDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	lookupPaths := []path.Expression{
+		path.MatchRoot("id"),
+		path.MatchRoot("username"),
+		path.MatchRoot("email"),
+		path.MatchRoot("external_id"),
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Looks up a Pterodactyl user by exactly one of id, username, email, or external_id.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int32Attribute{
+				Description: "The ID of the user.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Int32{
+					int32validator.ExactlyOneOf(lookupPaths...),
+				},
+			},
+			"username": schema.StringAttribute{
+				Description: "The username of the user.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(lookupPaths...),
+				},
+			},
+			"email": schema.StringAttribute{
+				Description: "The email of the user.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(lookupPaths...),
+				},
+			},
+			"external_id": schema.StringAttribute{
+				Description: "The external identifier of the user, such as an IdP-assigned ID used for SSO.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(lookupPaths...),
+				},
+			},
+			"first_name": schema.StringAttribute{
+				Description: "The first name of the user.",
+				Computed:    true,
+			},
+			"last_name": schema.StringAttribute{
+				Description: "The last name of the user.",
+				Computed:    true,
+			},
+			"root_admin": schema.BoolAttribute{
+				Description: "Whether the user has administrative access to the panel.",
+				Computed:    true,
+			},
+			"language": schema.StringAttribute{
+				Description: "The locale used by the panel for this user.",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The creation date of the user.",
+				Computed:    true,
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "The last update date of the user.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config userDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := d.resolveUser(config)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Pterodactyl User",
+			"Could not find user: "+err.Error(),
+		)
+		return
+	}
+
+	var resourceModel userResourceModel
+	flattenUser(user, &resourceModel)
+
+	state := userDataSourceModel{
+		ID:         resourceModel.ID,
+		Username:   resourceModel.Username,
+		Email:      resourceModel.Email,
+		ExternalID: resourceModel.ExternalID,
+		FirstName:  resourceModel.FirstName,
+		LastName:   resourceModel.LastName,
+		RootAdmin:  resourceModel.RootAdmin,
+		Language:   resourceModel.Language,
+		CreatedAt:  resourceModel.CreatedAt,
+		UpdatedAt:  resourceModel.UpdatedAt,
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// resolveUser dispatches to the client call matching whichever lookup
+// attribute was set in config. The schema's ExactlyOneOf validators
+// guarantee exactly one of these is non-null by the time Read runs.
+func (d *userDataSource) resolveUser(config userDataSourceModel) (*pterodactyl.User, error) {
+	switch {
+	case !config.ID.IsNull():
+		return d.client.GetUser(config.ID.ValueInt32())
+	case !config.Username.IsNull():
+		return d.client.GetUserUsername(config.Username.ValueString())
+	case !config.Email.IsNull():
+		return d.findUserByEmail(config.Email.ValueString())
+	case !config.ExternalID.IsNull():
+		return d.client.GetUserByExternalID(config.ExternalID.ValueString())
+	default:
+		return nil, fmt.Errorf("one of id, username, email, or external_id must be set")
+	}
+}
+
+// findUserByEmail scans the paginated user list for a matching email, since
+// the Panel API has no dedicated lookup-by-email endpoint.
+func (d *userDataSource) findUserByEmail(email string) (*pterodactyl.User, error) {
+	users, err := listAllUsers(d.client)
+	if err != nil {
+		return nil, err
+	}
+	for i := range users {
+		if users[i].Email == email {
+			return &users[i], nil
+		}
+	}
+	return nil, fmt.Errorf("no user found with email %q", email)
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *userDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*pterodactyl.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *pterodactyl.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
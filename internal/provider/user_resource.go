@@ -2,19 +2,141 @@ package provider
 
 import (
 	"context"
+	"crypto/rand"
 	"fmt"
+	"math/big"
+	"regexp"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/Lela810/pterodactyl-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// usernameRegex enforces the character class Pterodactyl accepts for
+// usernames: letters, numbers, underscores, periods, and hyphens.
+var usernameRegex = regexp.MustCompile(`^[a-zA-Z0-9_.-]+$`)
+
+// emailRegex is a pragmatic RFC 5322 address matcher: strict enough to
+// reject obviously malformed input, permissive enough not to reject real
+// addresses the way a fully spec-compliant grammar would.
+var emailRegex = regexp.MustCompile(`^[a-zA-Z0-9.!#$%&'*+/=?^_` + "`" + `{|}~-]+@[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(?:\.[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)+$`)
+
+// supportedLanguages are the locales bundled with the Pterodactyl Panel.
+var supportedLanguages = []string{
+	"en", "de", "fr", "nl", "pl", "pt_BR", "ru", "sv", "tr", "uk", "zh", "zh_TW",
+}
+
+// generatedPasswordCharset is the character set used by generateRandomPassword.
+// It avoids ambiguous characters while still satisfying typical panel complexity rules.
+const generatedPasswordCharset = "ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz23456789!@#$%^&*"
+
+// generatedPasswordLength is the length of passwords produced by generate_password.
+const generatedPasswordLength = 24
+
+// generateRandomPassword returns a cryptographically random password suitable
+// for use as a Pterodactyl user's initial password.
+func generateRandomPassword() (string, error) {
+	charsetLen := big.NewInt(int64(len(generatedPasswordCharset)))
+	password := make([]byte, generatedPasswordLength)
+	for i := range password {
+		n, err := rand.Int(rand.Reader, charsetLen)
+		if err != nil {
+			return "", err
+		}
+		password[i] = generatedPasswordCharset[n.Int64()]
+	}
+	return string(password), nil
+}
+
+// resolvePassword decides what password (if any) to send to the API.
+// configPassword is the write-only value read directly from config, since
+// write-only attributes are always null in plan/state. A configured
+// password always wins and clears any previously generated one. Otherwise,
+// a password is generated only if generate_password is set and no password
+// has been generated before — existingGeneratedPassword comes from prior
+// state, so this never rotates the password on a plan where nothing
+// relevant changed. sendPassword is empty when there is nothing new to send.
+func resolvePassword(configPassword types.String, generatePassword bool, existingGeneratedPassword types.String) (sendPassword string, generatedPassword types.String, err error) {
+	if !configPassword.IsNull() {
+		return configPassword.ValueString(), types.StringNull(), nil
+	}
+
+	if !generatePassword {
+		return "", types.StringNull(), nil
+	}
+
+	if !existingGeneratedPassword.IsNull() {
+		return "", existingGeneratedPassword, nil
+	}
+
+	generated, err := generateRandomPassword()
+	if err != nil {
+		return "", types.StringNull(), err
+	}
+	return generated, types.StringValue(generated), nil
+}
+
+// generatedPasswordModifier keeps the plan for generated_password
+// consistent with what Update will actually produce: null once a password
+// is explicitly configured or generate_password is turned off, the prior
+// value while generation stays on, and unknown (left to Update to compute)
+// the first time a password gets generated. Plain UseStateForUnknown isn't
+// enough here because it always carries the prior value forward, which
+// disagrees with Update clearing it on the generate-to-explicit switch.
+type generatedPasswordModifier struct{}
+
+func (m generatedPasswordModifier) Description(_ context.Context) string {
+	return "Keeps generated_password consistent with whether a password is explicitly configured or generated."
+}
+
+func (m generatedPasswordModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m generatedPasswordModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.State.Raw.IsNull() {
+		// Resource is being created; nothing to preserve yet.
+		return
+	}
+
+	var planGeneratePassword types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("generate_password"), &planGeneratePassword)...)
+	var configPassword types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &configPassword)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !configPassword.IsNull() || !planGeneratePassword.ValueBool() {
+		resp.PlanValue = types.StringNull()
+		return
+	}
+
+	if req.StateValue.IsNull() {
+		// No password has been generated yet; leave the plan unknown so
+		// Update's freshly generated value doesn't disagree with it.
+		return
+	}
+
+	resp.PlanValue = req.StateValue
+}
+
+func generatedPasswordPlanModifier() planmodifier.String {
+	return generatedPasswordModifier{}
+}
+
 // Ensure the implementation satisfies the expected interfaces.
 var (
 	_ resource.Resource                = &userResource{}
@@ -34,13 +156,42 @@ type userResource struct {
 
 // userResourceModel maps the resource schema data.
 type userResourceModel struct {
-	ID        types.Int32  `tfsdk:"id"`
-	Username  types.String `tfsdk:"username"`
-	Email     types.String `tfsdk:"email"`
-	FirstName types.String `tfsdk:"first_name"`
-	LastName  types.String `tfsdk:"last_name"`
-	CreatedAt types.String `tfsdk:"created_at"`
-	UpdatedAt types.String `tfsdk:"updated_at"`
+	ID                types.Int32  `tfsdk:"id"`
+	Username          types.String `tfsdk:"username"`
+	Email             types.String `tfsdk:"email"`
+	FirstName         types.String `tfsdk:"first_name"`
+	LastName          types.String `tfsdk:"last_name"`
+	RootAdmin         types.Bool   `tfsdk:"root_admin"`
+	Language          types.String `tfsdk:"language"`
+	ExternalID        types.String `tfsdk:"external_id"`
+	Password          types.String `tfsdk:"password"`
+	GeneratePassword  types.Bool   `tfsdk:"generate_password"`
+	GeneratedPassword types.String `tfsdk:"generated_password"`
+	CreatedAt         types.String `tfsdk:"created_at"`
+	UpdatedAt         types.String `tfsdk:"updated_at"`
+}
+
+// flattenUser copies the attributes of a Pterodactyl API user into a
+// userResourceModel, leaving Password and GeneratePassword untouched since
+// neither is ever returned by the API.
+func flattenUser(user *pterodactyl.User, model *userResourceModel) {
+	model.ID = types.Int32Value(user.ID)
+	model.Username = types.StringValue(user.Username)
+	model.Email = types.StringValue(user.Email)
+	model.FirstName = types.StringValue(user.FirstName)
+	model.LastName = types.StringValue(user.LastName)
+	model.RootAdmin = types.BoolValue(user.RootAdmin)
+	model.Language = types.StringValue(user.Language)
+	// external_id is optional; the API represents "unset" as an empty
+	// string, which must map to null so it doesn't perpetually diff
+	// against a null config value.
+	if user.ExternalID == "" {
+		model.ExternalID = types.StringNull()
+	} else {
+		model.ExternalID = types.StringValue(user.ExternalID)
+	}
+	model.CreatedAt = types.StringValue(user.CreatedAt.Format(time.RFC3339))
+	model.UpdatedAt = types.StringValue(user.UpdatedAt.Format(time.RFC3339))
 }
 
 // Metadata returns the resource type name.
@@ -61,12 +212,22 @@ func (r *userResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				},
 			},
 			"username": schema.StringAttribute{
-				Description: "The username of the user.",
+				Description: "The username of the user. The Pterodactyl Panel treats this as an immutable login, so changing it replaces the user.",
 				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 191),
+					stringvalidator.RegexMatches(usernameRegex, "must contain only letters, numbers, underscores, periods, and hyphens"),
+				},
 			},
 			"email": schema.StringAttribute{
 				Description: "The email of the user.",
 				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(emailRegex, "must be a valid RFC 5322 email address"),
+				},
 			},
 			"first_name": schema.StringAttribute{
 				Description: "The first name of the user.",
@@ -76,6 +237,43 @@ func (r *userResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Description: "The last name of the user.",
 				Required:    true,
 			},
+			"root_admin": schema.BoolAttribute{
+				Description: "Whether the user has administrative access to the panel.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"language": schema.StringAttribute{
+				Description: "The locale used by the panel for this user.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("en"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(supportedLanguages...),
+				},
+			},
+			"external_id": schema.StringAttribute{
+				Description: "An identifier used to link this user to a user on another system, such as an IdP used for SSO.",
+				Optional:    true,
+			},
+			"password": schema.StringAttribute{
+				Description: "The password for the user. The Panel API never returns this value, so Terraform preserves whatever was last written to state. Leave unset and set `generate_password` to have Terraform generate one.",
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+			},
+			"generate_password": schema.BoolAttribute{
+				Description: "When true and `password` is not set, Terraform generates a random password on create and stores it in `generated_password`.",
+				Optional:    true,
+			},
+			"generated_password": schema.StringAttribute{
+				Description: "The password generated by Terraform when `generate_password` is true and `password` is unset.",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					generatedPasswordPlanModifier(),
+				},
+			},
 			"created_at": schema.StringAttribute{
 				Description: "The creation date of the user.",
 				Computed:    true,
@@ -101,12 +299,34 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	// password is write-only, so it is always null on req.Plan; it must be
+	// read directly from config instead.
+	var configPassword types.String
+	diags = req.Config.GetAttribute(ctx, path.Root("password"), &configPassword)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	password, generatedPassword, err := resolvePassword(configPassword, plan.GeneratePassword.ValueBool(), types.StringNull())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Generating Password",
+			"Could not generate a random password for the user: "+err.Error(),
+		)
+		return
+	}
+
 	// Create partial user
 	partialUser := pterodactyl.PartialUser{
-		Username:  plan.Username.ValueString(),
-		Email:     plan.Email.ValueString(),
-		FirstName: plan.FirstName.ValueString(),
-		LastName:  plan.LastName.ValueString(),
+		Username:   plan.Username.ValueString(),
+		Email:      plan.Email.ValueString(),
+		FirstName:  plan.FirstName.ValueString(),
+		LastName:   plan.LastName.ValueString(),
+		RootAdmin:  plan.RootAdmin.ValueBool(),
+		Language:   plan.Language.ValueString(),
+		ExternalID: plan.ExternalID.ValueString(),
+		Password:   password,
 	}
 
 	// Create new user
@@ -121,6 +341,7 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 	// Map response body to schema and populate Computed attribute values
 	plan.ID = types.Int32Value(user.ID)
+	plan.GeneratedPassword = generatedPassword
 	plan.CreatedAt = types.StringValue(user.CreatedAt.Format(time.RFC3339))
 	plan.UpdatedAt = types.StringValue(time.Now().Format(time.RFC3339))
 
@@ -152,12 +373,23 @@ func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	// Overwrite items with refreshed state
-	state.Email = types.StringValue(user.Email)
-	state.FirstName = types.StringValue(user.FirstName)
-	state.LastName = types.StringValue(user.LastName)
-	state.UpdatedAt = types.StringValue(user.UpdatedAt.Format(time.RFC3339))
-	state.CreatedAt = types.StringValue(user.CreatedAt.Format(time.RFC3339))
+	// If external_id is set and the panel's value has drifted from it,
+	// surface that as a warning. The panel's value still flows into state
+	// below via flattenUser, so the drift shows up as an actionable change
+	// on the next plan instead of being silently suppressed.
+	if !state.ExternalID.IsNull() && state.ExternalID.ValueString() != user.ExternalID {
+		resp.Diagnostics.AddWarning(
+			"Pterodactyl User external_id Drifted",
+			fmt.Sprintf("Configured external_id %q no longer matches the panel's value %q for user %d. "+
+				"Update the configuration or re-import to accept the panel's value.",
+				state.ExternalID.ValueString(), user.ExternalID, user.ID),
+		)
+	}
+
+	// Overwrite items with refreshed state. Password is intentionally left
+	// untouched: the Panel API never returns it, so the last known value
+	// (configured or generated) stays in state.
+	flattenUser(user, &state)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -176,12 +408,44 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	// Retrieve the prior state to see whether a password was already
+	// generated, so generate_password never rotates the password on an
+	// apply that isn't touching it.
+	var priorState userResourceModel
+	diags = req.State.Get(ctx, &priorState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// password is write-only, so it is always null on req.Plan; it must be
+	// read directly from config instead.
+	var configPassword types.String
+	diags = req.Config.GetAttribute(ctx, path.Root("password"), &configPassword)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	password, generatedPassword, err := resolvePassword(configPassword, plan.GeneratePassword.ValueBool(), priorState.GeneratedPassword)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Generating Password",
+			"Could not generate a random password for the user: "+err.Error(),
+		)
+		return
+	}
+
 	// Create partial user
 	var partialUser pterodactyl.PartialUser = pterodactyl.PartialUser{
-		Username:  plan.Username.ValueString(),
-		Email:     plan.Email.ValueString(),
-		FirstName: plan.FirstName.ValueString(),
-		LastName:  plan.LastName.ValueString(),
+		Username:   plan.Username.ValueString(),
+		Email:      plan.Email.ValueString(),
+		FirstName:  plan.FirstName.ValueString(),
+		LastName:   plan.LastName.ValueString(),
+		RootAdmin:  plan.RootAdmin.ValueBool(),
+		Language:   plan.Language.ValueString(),
+		ExternalID: plan.ExternalID.ValueString(),
+		Password:   password,
 	}
 
 	// Update existing user
@@ -194,11 +458,11 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	// Update resource state with updated values
-	plan.Email = types.StringValue(user.Email)
-	plan.FirstName = types.StringValue(user.FirstName)
-	plan.LastName = types.StringValue(user.LastName)
-	plan.UpdatedAt = types.StringValue(user.UpdatedAt.Format(time.RFC3339))
+	// Update resource state with updated values. flattenUser applies the
+	// same empty-external_id-to-null mapping Read relies on, so Update
+	// doesn't reintroduce the inconsistency flattenUser exists to prevent.
+	flattenUser(user, &plan)
+	plan.GeneratedPassword = generatedPassword
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -250,10 +514,26 @@ func (r *userResource) Configure(_ context.Context, req resource.ConfigureReques
 	r.client = client
 }
 
-func (r *userResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	username := req.ID
+// resolveImportUser resolves an import ID to a Pterodactyl user. The ID may
+// be a bare username (the historical behavior), or one of the prefixed
+// forms "id:<n>" or "external_id:<value>" for identifying SSO-provisioned
+// users that aren't keyed by username.
+func (r *userResource) resolveImportUser(importID string) (*pterodactyl.User, error) {
+	if rest, ok := strings.CutPrefix(importID, "external_id:"); ok {
+		return r.client.GetUserByExternalID(rest)
+	}
+	if rest, ok := strings.CutPrefix(importID, "id:"); ok {
+		id, err := strconv.ParseInt(rest, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid id %q: %w", rest, err)
+		}
+		return r.client.GetUser(int32(id))
+	}
+	return r.client.GetUserUsername(importID)
+}
 
-	user, err := r.client.GetUserUsername(username)
+func (r *userResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	user, err := r.resolveImportUser(req.ID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Importing Pterodactyl User",
@@ -262,16 +542,14 @@ func (r *userResource) ImportState(ctx context.Context, req resource.ImportState
 		return
 	}
 
-	// Map response body to schema and populate Computed attribute values
+	// Map response body to schema and populate Computed attribute values.
+	// Password is never known at import time, since the Panel API never
+	// returns it.
 	state := userResourceModel{
-		ID:        types.Int32Value(user.ID),
-		Username:  types.StringValue(user.Username),
-		Email:     types.StringValue(user.Email),
-		FirstName: types.StringValue(user.FirstName),
-		LastName:  types.StringValue(user.LastName),
-		CreatedAt: types.StringValue(user.CreatedAt.Format(time.RFC3339)),
-		UpdatedAt: types.StringValue(user.UpdatedAt.Format(time.RFC3339)),
+		Password:          types.StringNull(),
+		GeneratedPassword: types.StringNull(),
 	}
+	flattenUser(user, &state)
 
 	// Set state to fully populated data
 	diags := resp.State.Set(ctx, state)
@@ -0,0 +1,190 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// baseUserConfig returns a pterodactyl_user config with every required
+// attribute set to a valid value, except for the ones supplied in
+// overrides, which are spliced in verbatim (e.g. `email = "not-an-email"`).
+func baseUserConfig(overrides string) string {
+	return providerConfig + fmt.Sprintf(`
+resource "pterodactyl_user" "test" {
+  username   = "validusername"
+  email      = "valid@example.com"
+  first_name = "Test"
+  last_name  = "User"
+%s
+}
+`, overrides)
+}
+
+// TestAccUserResource_InvalidEmailRejected and the validator tests below it
+// never reach apply: the ExpectError is raised at plan time, so unlike the
+// tests further down this file they don't need a live panel and skip
+// testAccPreCheck accordingly.
+func TestAccUserResource_InvalidEmailRejected(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      baseUserConfig(`  email = "not-an-email"`),
+				ExpectError: regexp.MustCompile(`must be a valid RFC 5322 email address`),
+			},
+		},
+	})
+}
+
+func TestAccUserResource_UsernameTooShortRejected(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "pterodactyl_user" "test" {
+  username   = ""
+  email      = "valid@example.com"
+  first_name = "Test"
+  last_name  = "User"
+}
+`,
+				ExpectError: regexp.MustCompile(`(?i)string length must be between 1 and 191`),
+			},
+		},
+	})
+}
+
+func TestAccUserResource_UsernameInvalidCharsetRejected(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "pterodactyl_user" "test" {
+  username   = "not a valid username!"
+  email      = "valid@example.com"
+  first_name = "Test"
+  last_name  = "User"
+}
+`,
+				ExpectError: regexp.MustCompile(`must contain only letters, numbers, underscores, periods, and hyphens`),
+			},
+		},
+	})
+}
+
+func TestAccUserResource_UnsupportedLanguageRejected(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      baseUserConfig(`  language = "xx-not-a-locale"`),
+				ExpectError: regexp.MustCompile(`(?i)value must be one of`),
+			},
+		},
+	})
+}
+
+// TestAccUserResource_UsernameChangeRequiresReplace confirms that changing
+// username plans a destroy/create rather than an in-place update, since the
+// Panel API treats it as an immutable login for some panel versions.
+func TestAccUserResource_UsernameChangeRequiresReplace(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: baseUserConfig(""),
+			},
+			{
+				Config: baseUserConfig(`  username = "adifferentusername"`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("pterodactyl_user.test", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+			},
+		},
+	})
+}
+
+// TestAccUserResource_ImportByUsername covers the historical import form:
+// a bare username.
+func TestAccUserResource_ImportByUsername(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: baseUserConfig(""),
+			},
+			{
+				ResourceName:            "pterodactyl_user.test",
+				ImportState:             true,
+				ImportStateId:           "validusername",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password", "generate_password", "generated_password"},
+			},
+		},
+	})
+}
+
+// TestAccUserResource_ImportByID covers the "id:<n>" import form.
+func TestAccUserResource_ImportByID(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: baseUserConfig(""),
+			},
+			{
+				ResourceName:            "pterodactyl_user.test",
+				ImportState:             true,
+				ImportStateIdFunc:       importStateIDPrefixed("id", "id"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password", "generate_password", "generated_password"},
+			},
+		},
+	})
+}
+
+// TestAccUserResource_ImportByExternalID covers the "external_id:<value>"
+// import form used for SSO-provisioned users.
+func TestAccUserResource_ImportByExternalID(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: baseUserConfig(`  external_id = "sso-user-1"`),
+			},
+			{
+				ResourceName:            "pterodactyl_user.test",
+				ImportState:             true,
+				ImportStateIdFunc:       importStateIDPrefixed("external_id", "external_id"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password", "generate_password", "generated_password"},
+			},
+		},
+	})
+}
+
+// importStateIDPrefixed builds an ImportStateIdFunc that reads attribute
+// from the resource's state and formats it as "prefix:<value>", matching
+// the forms accepted by resolveImportUser.
+func importStateIDPrefixed(prefix, attribute string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources["pterodactyl_user.test"]
+		if !ok {
+			return "", fmt.Errorf("resource not found: pterodactyl_user.test")
+		}
+		return fmt.Sprintf("%s:%s", prefix, rs.Primary.Attributes[attribute]), nil
+	}
+}
@@ -0,0 +1,208 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Lela810/pterodactyl-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &usersDataSource{}
+	_ datasource.DataSourceWithConfigure = &usersDataSource{}
+)
+
+// NewUsersDataSource is a helper function to simplify the provider implementation.
+func NewUsersDataSource() datasource.DataSource {
+	return &usersDataSource{}
+}
+
+// usersDataSource is the data source implementation.
+type usersDataSource struct {
+	client *pterodactyl.Client
+}
+
+// usersDataSourceModel maps the data source schema data.
+type usersDataSourceModel struct {
+	FilterEmailContains    types.String          `tfsdk:"filter_email_contains"`
+	FilterUsernameContains types.String          `tfsdk:"filter_username_contains"`
+	RootAdminOnly          types.Bool            `tfsdk:"root_admin_only"`
+	Users                  []userDataSourceModel `tfsdk:"users"`
+}
+
+// Metadata returns the data source type name.
+func (d *usersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+// Schema defines the schema for the data source.
+func (d *usersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists Pterodactyl users, optionally filtered by email, username, or admin status.",
+		Attributes: map[string]schema.Attribute{
+			"filter_email_contains": schema.StringAttribute{
+				Description: "Only return users whose email contains this substring.",
+				Optional:    true,
+			},
+			"filter_username_contains": schema.StringAttribute{
+				Description: "Only return users whose username contains this substring.",
+				Optional:    true,
+			},
+			"root_admin_only": schema.BoolAttribute{
+				Description: "Only return users with administrative access to the panel.",
+				Optional:    true,
+			},
+			"users": schema.ListNestedAttribute{
+				Description: "The users matching the given filters.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int32Attribute{
+							Description: "The ID of the user.",
+							Computed:    true,
+						},
+						"username": schema.StringAttribute{
+							Description: "The username of the user.",
+							Computed:    true,
+						},
+						"email": schema.StringAttribute{
+							Description: "The email of the user.",
+							Computed:    true,
+						},
+						"external_id": schema.StringAttribute{
+							Description: "The external identifier of the user, such as an IdP-assigned ID used for SSO.",
+							Computed:    true,
+						},
+						"first_name": schema.StringAttribute{
+							Description: "The first name of the user.",
+							Computed:    true,
+						},
+						"last_name": schema.StringAttribute{
+							Description: "The last name of the user.",
+							Computed:    true,
+						},
+						"root_admin": schema.BoolAttribute{
+							Description: "Whether the user has administrative access to the panel.",
+							Computed:    true,
+						},
+						"language": schema.StringAttribute{
+							Description: "The locale used by the panel for this user.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "The creation date of the user.",
+							Computed:    true,
+						},
+						"updated_at": schema.StringAttribute{
+							Description: "The last update date of the user.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config usersDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	users, err := listAllUsers(d.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Pterodactyl Users",
+			"Could not list users, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	config.Users = make([]userDataSourceModel, 0, len(users))
+	for i := range users {
+		user := &users[i]
+
+		if email := config.FilterEmailContains.ValueString(); email != "" && !strings.Contains(user.Email, email) {
+			continue
+		}
+		if username := config.FilterUsernameContains.ValueString(); username != "" && !strings.Contains(user.Username, username) {
+			continue
+		}
+		if config.RootAdminOnly.ValueBool() && !user.RootAdmin {
+			continue
+		}
+
+		var resourceModel userResourceModel
+		flattenUser(user, &resourceModel)
+		config.Users = append(config.Users, userDataSourceModel{
+			ID:         resourceModel.ID,
+			Username:   resourceModel.Username,
+			Email:      resourceModel.Email,
+			ExternalID: resourceModel.ExternalID,
+			FirstName:  resourceModel.FirstName,
+			LastName:   resourceModel.LastName,
+			RootAdmin:  resourceModel.RootAdmin,
+			Language:   resourceModel.Language,
+			CreatedAt:  resourceModel.CreatedAt,
+			UpdatedAt:  resourceModel.UpdatedAt,
+		})
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *usersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*pterodactyl.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *pterodactyl.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// listAllUsers walks every page of the Panel's user list and returns the
+// combined result. The Panel API paginates user listings, so callers that
+// need the full set (such as the plural data source or email/external_id
+// lookups) must page through until the last page is reached.
+func listAllUsers(client *pterodactyl.Client) ([]pterodactyl.User, error) {
+	var all []pterodactyl.User
+
+	page := 1
+	for {
+		result, err := client.ListUsers(page)
+		if err != nil {
+			return nil, err
+		}
+
+		all = append(all, result.Data...)
+
+		if result.Meta.Pagination.CurrentPage >= result.Meta.Pagination.TotalPages {
+			break
+		}
+		page++
+	}
+
+	return all, nil
+}